@@ -0,0 +1,108 @@
+/*
+ * This file is part of goji.
+ *
+ * Copyright (c) 2024 Dima Krasner
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package goji
+
+// boolOp identifies the boolean operator a [*Builder] built by [And] or [Or]
+// joins its children with. It is used to decide whether a child needs to be
+// parenthesized when it is itself passed to [And] or [Or].
+type boolOp int
+
+const (
+	boolOpNone boolOp = iota
+	boolOpAnd
+	boolOpOr
+)
+
+// Expr returns a leaf [*Builder] for a parameterized SQL expression, for use
+// as a child of [And], [Or] or [Not].
+func Expr(sql string, args ...any) *Builder {
+	return Join("").Add(sql, args...)
+}
+
+// And returns a [*Builder] whose children are joined with " AND " and
+// parenthesized as needed so precedence is preserved, e.g. And(Or(a, b), c)
+// renders "(a OR b) AND c", while And(a, And(b, c)) flattens to
+// "a AND b AND c".
+func And(children ...*Builder) *Builder {
+	return cond(boolOpAnd, " AND ", children)
+}
+
+// Or is like [And], but joins its children with " OR ".
+func Or(children ...*Builder) *Builder {
+	return cond(boolOpOr, " OR ", children)
+}
+
+func cond(op boolOp, delim string, children []*Builder) *Builder {
+	b := &Builder{delim: delim, condOp: op, condN: len(children)}
+
+	for _, c := range children {
+		s, args, err := c.End()
+		if err != nil {
+			b.setErr(err)
+			continue
+		}
+
+		if c.condOp != boolOpNone && c.condOp != op && c.condN >= 2 {
+			s = "(" + s + ")"
+		}
+
+		b.Add(s, args...)
+	}
+
+	return b
+}
+
+// Not returns a [*Builder] that renders child negated and parenthesized,
+// e.g. Not(Eq("deleted", true)) renders "NOT (deleted = ?)".
+func Not(child *Builder) *Builder {
+	s, args, err := child.End()
+	if err != nil {
+		b := Join("")
+		b.setErr(err)
+		return b
+	}
+
+	return Join("").Add("NOT ("+s+")", args...)
+}
+
+// Between returns a leaf [*Builder] rendering col BETWEEN lo AND hi.
+func Between(col string, lo, hi any) *Builder {
+	return Join("").Add(col+" BETWEEN ? AND ?", lo, hi)
+}
+
+// Eq returns a leaf [*Builder] rendering col = ?, parameterized with v.
+func Eq(col string, v any) *Builder {
+	return Join("").Add(col+" = ?", v)
+}
+
+// InCol returns a leaf [*Builder] rendering col as an IN expression against
+// a parenthesized, comma-separated list of "?" placeholders, e.g.
+// InCol("id", 1, 2, 3) renders "id IN (?,?,?)" with args [1 2 3]. An empty
+// vals renders "col IN (NULL)"; the expression is rendered when InCol is
+// called, so calling [Builder.EmptyIn] on the returned [*Builder] does not
+// change it.
+func InCol(col string, vals ...any) *Builder {
+	return Join("").Add(col+" IN (?...)", vals)
+}
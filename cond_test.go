@@ -0,0 +1,86 @@
+/*
+ * This file is part of goji.
+ *
+ * Copyright (c) 2024 Dima Krasner
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package goji_test
+
+import (
+	"fmt"
+
+	"github.com/dimkr/goji"
+)
+
+func ExampleAnd() {
+	query, args := goji.Join(" ").
+		Add("SELECT * FROM sales WHERE").
+		Add(goji.And(
+			goji.Or(goji.Eq("region", "EU"), goji.Eq("region", "US")),
+			goji.Eq("deleted", false),
+		)).
+		MustEnd()
+
+	fmt.Printf("%v with %v\n", query, args)
+	// Output: SELECT * FROM sales WHERE (region = ? OR region = ?) AND deleted = ? with [EU US false]
+}
+
+func ExampleAnd_flatten() {
+	query, args := goji.Join(" ").
+		Add("SELECT * FROM sales WHERE").
+		Add(goji.And(
+			goji.Eq("deleted", false),
+			goji.And(goji.Eq("region", "EU"), goji.Eq("currency", "USD")),
+		)).
+		MustEnd()
+
+	fmt.Printf("%v with %v\n", query, args)
+	// Output: SELECT * FROM sales WHERE deleted = ? AND region = ? AND currency = ? with [false EU USD]
+}
+
+func ExampleNot() {
+	query, args := goji.Join(" ").
+		Add("SELECT * FROM sales WHERE").
+		Add(goji.Not(goji.Between("price", 10, 20))).
+		MustEnd()
+
+	fmt.Printf("%v with %v\n", query, args)
+	// Output: SELECT * FROM sales WHERE NOT (price BETWEEN ? AND ?) with [10 20]
+}
+
+func ExampleInCol() {
+	query, args := goji.Join(" ").
+		Add("SELECT * FROM sales WHERE").
+		Add(goji.InCol("region", "EU", "US")).
+		MustEnd()
+
+	fmt.Printf("%v with %v\n", query, args)
+	// Output: SELECT * FROM sales WHERE region IN (?,?) with [EU US]
+}
+
+func ExampleAnd_error() {
+	bad := goji.Join(" ").AddNamed("a = :missing", map[string]any{})
+
+	_, _, err := goji.And(goji.Eq("b", 1), bad).End()
+
+	fmt.Println(err)
+	// Output: goji: unknown named parameter "missing"
+}
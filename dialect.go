@@ -0,0 +1,143 @@
+/*
+ * This file is part of goji.
+ *
+ * Copyright (c) 2024 Dima Krasner
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package goji
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Dialect identifies the placeholder syntax a [database/sql] driver expects.
+type Dialect int
+
+const (
+	// Question is the default dialect: placeholders are left as-is.
+	Question Dialect = iota
+
+	// Dollar rewrites placeholders to $1, $2, ... (Postgres, SQLite).
+	Dollar
+
+	// AtP rewrites placeholders to @p1, @p2, ... (SQL Server).
+	AtP
+
+	// Colon rewrites placeholders to :1, :2, ... (Oracle).
+	Colon
+)
+
+// Dialect sets the dialect used to rewrite the "?" placeholders emitted by
+// [Builder.Add] when the built string is retrieved with [Builder.End] or
+// [Builder.MustEnd].
+func (b *Builder) Dialect(dialect Dialect) *Builder {
+	b.dialect = dialect
+	return b
+}
+
+// JoinDialect is like [Join], but the returned [Builder] rewrites its
+// placeholders for dialect.
+func JoinDialect(delim string, dialect Dialect) *Builder {
+	return &Builder{delim: delim, dialect: dialect}
+}
+
+// Rebind rewrites the "?" placeholders in sql to dialect's syntax, numbering
+// them from 1. It ignores "?" inside single-quoted string literals (with
+// doubled-quote escapes) and double-quoted or backtick-quoted identifiers.
+func Rebind(sql string, dialect Dialect) string {
+	s, _ := rebind(sql, dialect, 0)
+	return s
+}
+
+// rebind rewrites the "?" placeholders in sql to dialect's syntax, continuing
+// the numbering from n, and returns the rewritten string along with the last
+// placeholder number used (n plus the number of placeholders it rewrote).
+func rebind(sql string, dialect Dialect, n int) (string, int) {
+	if dialect == Question {
+		return sql, n
+	}
+
+	var out strings.Builder
+	out.Grow(len(sql))
+
+	i := 0
+	for i < len(sql) {
+		switch c := sql[i]; c {
+		case '\'', '"', '`':
+			j := skipQuoted(sql, i)
+			out.WriteString(sql[i:j])
+			i = j
+
+		case '?':
+			n++
+			out.WriteString(placeholder(dialect, n))
+			i++
+
+		default:
+			out.WriteByte(c)
+			i++
+		}
+	}
+
+	return out.String(), n
+}
+
+// skipQuoted returns the index just past the quoted string or identifier
+// starting at i in s, where s[i] is a single quote, double quote or
+// backtick. A doubled single quote inside a single-quoted string is treated
+// as an escaped quote rather than the string's end.
+func skipQuoted(s string, i int) int {
+	q := s[i]
+
+	j := i + 1
+	for j < len(s) {
+		if s[j] == q {
+			if q == '\'' && j+1 < len(s) && s[j+1] == '\'' {
+				j += 2
+				continue
+			}
+
+			j++
+			break
+		}
+
+		j++
+	}
+
+	return j
+}
+
+func placeholder(dialect Dialect, n int) string {
+	switch dialect {
+	case Dollar:
+		return "$" + strconv.Itoa(n)
+
+	case AtP:
+		return "@p" + strconv.Itoa(n)
+
+	case Colon:
+		return ":" + strconv.Itoa(n)
+
+	default:
+		return "?"
+	}
+}
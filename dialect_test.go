@@ -0,0 +1,52 @@
+/*
+ * This file is part of goji.
+ *
+ * Copyright (c) 2024 Dima Krasner
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package goji_test
+
+import (
+	"fmt"
+
+	"github.com/dimkr/goji"
+)
+
+func ExampleBuilder_Dialect() {
+	filters := goji.Join(" AND ").
+		Add(`sales.price > ?`, 5).
+		Add(`sales.currency = '?'`).
+		Add(`sales.region <> ?`, "EU")
+
+	query, args := goji.Join(" ").
+		Dialect(goji.Dollar).
+		Add(`SELECT product FROM sales WHERE`).
+		Add(filters).
+		MustEnd()
+
+	fmt.Printf("%v with %v\n", query, args)
+	// Output: SELECT product FROM sales WHERE sales.price > $1 AND sales.currency = '?' AND sales.region <> $2 with [5 EU]
+}
+
+func ExampleRebind() {
+	fmt.Println(goji.Rebind(`SELECT * FROM t WHERE a = ? AND b = "c?d" AND c = ?`, goji.AtP))
+	// Output: SELECT * FROM t WHERE a = @p1 AND b = "c?d" AND c = @p2
+}
@@ -34,10 +34,14 @@ import (
 //
 // It can be used as a poor man's SQL query builder, for use with [database/sql].
 type Builder struct {
-	inner strings.Builder
-	delim string
-	args  []any
-	err   error
+	inner   strings.Builder
+	delim   string
+	args    []any
+	err     error
+	dialect Dialect
+	emptyIn string
+	condOp  boolOp
+	condN   int
 }
 
 // Join returns a new [Builder] which joins parameterized expressions with a given delimiter.
@@ -46,8 +50,12 @@ func Join(delim string) *Builder {
 }
 
 // End returns the built string and array of parameters.
+//
+// If a dialect was set with [Builder.Dialect] or [JoinDialect], the "?"
+// placeholders in the returned string are rewritten to that dialect's syntax.
 func (b *Builder) End() (string, []any, error) {
-	return b.inner.String(), b.args, b.err
+	s, _ := rebind(b.inner.String(), b.dialect, 0)
+	return s, b.args, b.err
 }
 
 // MustEnd is like [Builder.End] but panics on error.
@@ -56,11 +64,12 @@ func (b *Builder) MustEnd() (string, []any) {
 		panic("goji: " + b.err.Error())
 	}
 
-	return b.inner.String(), b.args
+	s, _ := rebind(b.inner.String(), b.dialect, 0)
+	return s, b.args
 }
 
 func (b *Builder) setErr(err error) {
-	if b.err != nil {
+	if b.err == nil {
 		b.err = err
 	}
 }
@@ -85,6 +94,8 @@ func (b *Builder) Add(exp any, arg ...any) *Builder {
 	var err error
 	switch v := exp.(type) {
 	case string:
+		v, arg = expandSlices(v, arg, b.emptyInOrDefault())
+
 		if b.inner.Len() > 0 {
 			v = b.delim + v
 		}
@@ -0,0 +1,139 @@
+/*
+ * This file is part of goji.
+ *
+ * Copyright (c) 2024 Dima Krasner
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package goji
+
+import (
+	"reflect"
+	"strings"
+)
+
+// sliceMarkers are the expression tokens that [Builder.Add] expands into a
+// comma-separated list of "?" placeholders, one per element of a slice
+// argument.
+var sliceMarkers = []string{"?...", "?*"}
+
+// defaultEmptyIn is the SQL emitted in place of a slice marker when the
+// corresponding argument is an empty slice.
+const defaultEmptyIn = "NULL"
+
+// EmptyIn sets the SQL that an empty slice argument expands to, in place of
+// a "?..." or "?*" marker. It defaults to "NULL".
+func (b *Builder) EmptyIn(sql string) *Builder {
+	b.emptyIn = sql
+	return b
+}
+
+func (b *Builder) emptyInOrDefault() string {
+	if b.emptyIn != "" {
+		return b.emptyIn
+	}
+
+	return defaultEmptyIn
+}
+
+// In returns a [*Builder] that renders values as a parenthesized,
+// comma-separated list of "?" placeholders, e.g. In(1, 2, 3) renders
+// "(?,?,?)" with args [1 2 3].
+func In(values ...any) *Builder {
+	return Join("").Add("(?...)", values)
+}
+
+// expandSlices rewrites the slice markers in exp, in order, with a
+// comma-separated list of "?" placeholders sized to each corresponding slice
+// argument in arg, and flattens the slices' elements into the returned args.
+// Non-slice arguments are passed through unchanged. emptyIn is the SQL used
+// in place of a marker whose slice argument is empty.
+func expandSlices(exp string, arg []any, emptyIn string) (string, []any) {
+	var out strings.Builder
+	var flat []any
+
+	pos := 0
+	for _, a := range arg {
+		if v, ok := sliceValue(a); ok {
+			idx, mlen := nextSliceMarker(exp, pos)
+			if idx < 0 {
+				flat = append(flat, a)
+				continue
+			}
+
+			out.WriteString(exp[pos:idx])
+
+			n := v.Len()
+			if n == 0 {
+				out.WriteString(emptyIn)
+			} else {
+				for i := 0; i < n; i++ {
+					if i > 0 {
+						out.WriteByte(',')
+					}
+
+					out.WriteByte('?')
+					flat = append(flat, v.Index(i).Interface())
+				}
+			}
+
+			pos = idx + mlen
+		} else {
+			flat = append(flat, a)
+		}
+	}
+
+	out.WriteString(exp[pos:])
+
+	return out.String(), flat
+}
+
+// sliceValue returns the reflected value of a, if a is a slice or array
+// other than []byte, which is treated as a scalar argument.
+func sliceValue(a any) (reflect.Value, bool) {
+	if _, ok := a.([]byte); ok {
+		return reflect.Value{}, false
+	}
+
+	v := reflect.ValueOf(a)
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		return v, true
+	}
+
+	return reflect.Value{}, false
+}
+
+// nextSliceMarker returns the index and length of the first slice marker in
+// exp at or after pos, or -1 if there is none.
+func nextSliceMarker(exp string, pos int) (int, int) {
+	best, blen := -1, 0
+
+	for _, m := range sliceMarkers {
+		if i := strings.Index(exp[pos:], m); i >= 0 && (best < 0 || i < best) {
+			best, blen = i, len(m)
+		}
+	}
+
+	if best < 0 {
+		return -1, 0
+	}
+
+	return pos + best, blen
+}
@@ -0,0 +1,60 @@
+/*
+ * This file is part of goji.
+ *
+ * Copyright (c) 2024 Dima Krasner
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package goji_test
+
+import (
+	"fmt"
+
+	"github.com/dimkr/goji"
+)
+
+func ExampleBuilder_Add_in() {
+	query, args := goji.Join(" ").
+		Add("SELECT * FROM t WHERE id IN (?...)", []int{1, 2, 3}).
+		Add("AND deleted = ?", false).
+		MustEnd()
+
+	fmt.Printf("%v with %v\n", query, args)
+	// Output: SELECT * FROM t WHERE id IN (?,?,?) AND deleted = ? with [1 2 3 false]
+}
+
+func ExampleBuilder_Add_inEmpty() {
+	query, args := goji.Join(" ").
+		Add("SELECT * FROM t WHERE id IN (?...)", []int{}).
+		MustEnd()
+
+	fmt.Printf("%v with %v\n", query, args)
+	// Output: SELECT * FROM t WHERE id IN (NULL) with []
+}
+
+func ExampleIn() {
+	query, args := goji.Join(" ").
+		Add("SELECT * FROM t WHERE id IN").
+		Add(goji.In(1, 2, 3)).
+		MustEnd()
+
+	fmt.Printf("%v with %v\n", query, args)
+	// Output: SELECT * FROM t WHERE id IN (?,?,?) with [1 2 3]
+}
@@ -0,0 +1,107 @@
+/*
+ * This file is part of goji.
+ *
+ * Copyright (c) 2024 Dima Krasner
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package goji
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AddNamed is like [Builder.Add], but expr uses named parameters (":name")
+// instead of "?" placeholders. Each ":name" is substituted with a "?" and
+// the corresponding value from params is appended to the builder's args, in
+// the order the names appear in expr; a name used more than once is
+// substituted, and its value appended, every time it occurs. "::" (a
+// Postgres type cast) and ":" inside string literals or quoted identifiers
+// are left untouched. A name missing from params sets the builder error.
+func (b *Builder) AddNamed(expr string, params map[string]any) *Builder {
+	sql, args, err := bindNamed(expr, params)
+	if err != nil {
+		b.setErr(err)
+	}
+
+	return b.Add(sql, args...)
+}
+
+func bindNamed(expr string, params map[string]any) (string, []any, error) {
+	var out strings.Builder
+	var args []any
+	var err error
+
+	i := 0
+	for i < len(expr) {
+		switch c := expr[i]; c {
+		case '\'', '"', '`':
+			j := skipQuoted(expr, i)
+			out.WriteString(expr[i:j])
+			i = j
+
+		case ':':
+			if i+1 < len(expr) && expr[i+1] == ':' {
+				out.WriteString("::")
+				i += 2
+				continue
+			}
+
+			j := i + 1
+			for j < len(expr) && isNameByte(expr[j]) {
+				j++
+			}
+
+			if j == i+1 {
+				out.WriteByte(':')
+				i++
+				continue
+			}
+
+			name := expr[i+1 : j]
+			if v, ok := params[name]; ok {
+				out.WriteByte('?')
+				args = append(args, v)
+			} else {
+				if err == nil {
+					err = fmt.Errorf("goji: unknown named parameter %q", name)
+				}
+
+				out.WriteString(expr[i:j])
+			}
+
+			i = j
+
+		default:
+			out.WriteByte(c)
+			i++
+		}
+	}
+
+	return out.String(), args, err
+}
+
+func isNameByte(c byte) bool {
+	return c == '_' ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z') ||
+		(c >= '0' && c <= '9')
+}
@@ -0,0 +1,53 @@
+/*
+ * This file is part of goji.
+ *
+ * Copyright (c) 2024 Dima Krasner
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package goji_test
+
+import (
+	"fmt"
+
+	"github.com/dimkr/goji"
+)
+
+func ExampleBuilder_AddNamed() {
+	query, args := goji.Join(" ").
+		Add("SELECT * FROM sales WHERE").
+		AddNamed("price > :min AND price < :max AND weight::int > :min", map[string]any{
+			"min": 5,
+			"max": 500,
+		}).
+		MustEnd()
+
+	fmt.Printf("%v with %v\n", query, args)
+	// Output: SELECT * FROM sales WHERE price > ? AND price < ? AND weight::int > ? with [5 500 5]
+}
+
+func ExampleBuilder_AddNamed_unknown() {
+	_, _, err := goji.Join(" ").
+		AddNamed("price > :min", map[string]any{}).
+		End()
+
+	fmt.Println(err)
+	// Output: goji: unknown named parameter "min"
+}
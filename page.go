@@ -0,0 +1,91 @@
+/*
+ * This file is part of goji.
+ *
+ * Copyright (c) 2024 Dima Krasner
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package goji
+
+// Page returns a [*Builder] rendering a "LIMIT ? OFFSET ?" clause for limit
+// and offset. When offset is 0, OFFSET is omitted; when limit is negative,
+// only OFFSET is emitted.
+func Page(limit, offset int) *Builder {
+	b := Join(" ")
+
+	if sql, args := pageExpr(limit, offset, Question); sql != "" {
+		b.Add(sql, args...)
+	}
+
+	return b
+}
+
+// Paged appends a pagination clause for limit and offset to b, following the
+// same omission rules as [Page]. If b has a [Dialect] of [AtP] (SQL Server /
+// DB2), the clause is rendered as FETCH FIRST/OFFSET ... ROWS instead of
+// LIMIT/OFFSET.
+func (b *Builder) Paged(limit, offset int) *Builder {
+	sql, args := pageExpr(limit, offset, b.dialect)
+	if sql == "" {
+		return b
+	}
+
+	return b.Add(sql, args...)
+}
+
+func pageExpr(limit, offset int, dialect Dialect) (string, []any) {
+	if dialect == AtP {
+		return pageExprFetch(limit, offset)
+	}
+
+	return pageExprLimit(limit, offset)
+}
+
+func pageExprLimit(limit, offset int) (string, []any) {
+	switch {
+	case limit < 0 && offset == 0:
+		return "", nil
+
+	case limit < 0:
+		return "OFFSET ?", []any{offset}
+
+	case offset == 0:
+		return "LIMIT ?", []any{limit}
+
+	default:
+		return "LIMIT ? OFFSET ?", []any{limit, offset}
+	}
+}
+
+func pageExprFetch(limit, offset int) (string, []any) {
+	switch {
+	case limit < 0 && offset == 0:
+		return "", nil
+
+	case limit < 0:
+		return "OFFSET ? ROWS", []any{offset}
+
+	case offset == 0:
+		return "FETCH FIRST ? ROWS ONLY", []any{limit}
+
+	default:
+		return "OFFSET ? ROWS FETCH NEXT ? ROWS ONLY", []any{offset, limit}
+	}
+}
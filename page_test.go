@@ -0,0 +1,62 @@
+/*
+ * This file is part of goji.
+ *
+ * Copyright (c) 2024 Dima Krasner
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package goji_test
+
+import (
+	"fmt"
+
+	"github.com/dimkr/goji"
+)
+
+func ExampleBuilder_Paged() {
+	query, args := goji.Join(" ").
+		Add("SELECT * FROM sales").
+		Paged(10, 20).
+		MustEnd()
+
+	fmt.Printf("%v with %v\n", query, args)
+	// Output: SELECT * FROM sales LIMIT ? OFFSET ? with [10 20]
+}
+
+func ExampleBuilder_Paged_sqlServer() {
+	query, args := goji.Join(" ").
+		Dialect(goji.AtP).
+		Add("SELECT * FROM sales").
+		Paged(10, 20).
+		MustEnd()
+
+	fmt.Printf("%v with %v\n", query, args)
+	// Output: SELECT * FROM sales OFFSET @p1 ROWS FETCH NEXT @p2 ROWS ONLY with [20 10]
+}
+
+func ExamplePage() {
+	query, args := goji.Join(" ").
+		Add("SELECT * FROM sales").
+		Add(goji.Page(10, 0)).
+		MustEnd()
+
+	fmt.Printf("%v with %v\n", query, args)
+	// Output: SELECT * FROM sales LIMIT ? with [10]
+}
@@ -0,0 +1,62 @@
+/*
+ * This file is part of goji.
+ *
+ * Copyright (c) 2024 Dima Krasner
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package goji
+
+// Union returns a [*Builder] that concatenates parts with " UNION ",
+// parenthesizing each one, e.g. Union(a, b) renders "(a) UNION (b)".
+func Union(parts ...*Builder) *Builder {
+	return setOp(" UNION ", parts)
+}
+
+// UnionAll is like [Union], but joins parts with " UNION ALL ".
+func UnionAll(parts ...*Builder) *Builder {
+	return setOp(" UNION ALL ", parts)
+}
+
+// Intersect is like [Union], but joins parts with " INTERSECT ".
+func Intersect(parts ...*Builder) *Builder {
+	return setOp(" INTERSECT ", parts)
+}
+
+// Except is like [Union], but joins parts with " EXCEPT ".
+func Except(parts ...*Builder) *Builder {
+	return setOp(" EXCEPT ", parts)
+}
+
+func setOp(kw string, parts []*Builder) *Builder {
+	b := Join(kw)
+
+	for _, p := range parts {
+		s, args, err := p.End()
+		if err != nil {
+			b.setErr(err)
+			continue
+		}
+
+		b.Add("("+s+")", args...)
+	}
+
+	return b
+}
@@ -0,0 +1,55 @@
+/*
+ * This file is part of goji.
+ *
+ * Copyright (c) 2024 Dima Krasner
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all
+ * copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+ * SOFTWARE.
+ */
+
+package goji_test
+
+import (
+	"fmt"
+
+	"github.com/dimkr/goji"
+)
+
+func ExampleUnion() {
+	a := goji.Join(" ").Add("SELECT id FROM sales WHERE region = ?", "EU")
+	b := goji.Join(" ").Add("SELECT id FROM sales WHERE region = ?", "US")
+
+	query, args := goji.Join(" ").
+		Add(goji.Union(a, b)).
+		Add("ORDER BY id DESC").
+		Add("LIMIT ?", 10).
+		MustEnd()
+
+	fmt.Printf("%v with %v\n", query, args)
+	// Output: (SELECT id FROM sales WHERE region = ?) UNION (SELECT id FROM sales WHERE region = ?) ORDER BY id DESC LIMIT ? with [EU US 10]
+}
+
+func ExampleUnion_error() {
+	bad := goji.Join(" ").AddNamed("a = :missing", map[string]any{})
+	ok := goji.Join(" ").Add("SELECT 1")
+
+	_, _, err := goji.Union(bad, ok).End()
+
+	fmt.Println(err)
+	// Output: goji: unknown named parameter "missing"
+}